@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/xml"
 	"fmt"
-	"net/http"
 	"net/url"
 	"time"
 )
@@ -48,36 +47,50 @@ type AtomEntry struct {
 	Id          string   `xml:"id"`      // required
 	Category    string   `xml:"category,omitempty"`
 	Content     *AtomContent
-	Rights      string `xml:"rights,omitempty"`
-	Source      string `xml:"source,omitempty"`
-	Published   string `xml:"published,omitempty"`
-	Contributor *AtomContributor
-	Links       []*AtomLink  `xml:"link"` // required if no child 'content' elements
-	Summary     *AtomSummary // required if content has src or content is base64
-	Author      *AtomAuthor  // required if feed lacks an author
+	Rights      string             `xml:"rights,omitempty"`
+	Source      string             `xml:"source,omitempty"`
+	Published   string             `xml:"published,omitempty"`
+	Contributor []*AtomContributor `xml:"contributor"`
+	Links       []*AtomLink        `xml:"link"` // required if no child 'content' elements
+	Summary     *AtomSummary       // required if content has src or content is base64
+	Author      []*AtomAuthor      `xml:"author"` // required if feed lacks an author
+	MediaGroup  *MediaGroup        `xml:"http://search.yahoo.com/mrss/ group,omitempty"`
 }
 
 type AtomLink struct {
 	XMLName xml.Name `xml:"link"`
 	Href    string   `xml:"href,attr"`
 	Rel     string   `xml:"rel,attr,omitempty"`
+	Type    string   `xml:"type,attr,omitempty"`
+	Length  string   `xml:"length,attr,omitempty"`
 }
 
 type AtomFeed struct {
-	XMLName     xml.Name         `xml:"feed"`
-	Xmlns       string           `xml:"xmlns,attr"`
-	Title       string           `xml:"title"`   // required
-	Id          string           `xml:"id"`      // required
-	Updated     string           `xml:"updated"` // required
-	Category    string           `xml:"category,omitempty"`
-	Icon        string           `xml:"icon,omitempty"`
-	Logo        string           `xml:"logo,omitempty"`
-	Rights      string           `xml:"rights,omitempty"` // copyright used
-	Subtitle    string           `xml:"subtitle,omitempty"`
-	Links       []*AtomLink      `xml:"link"`
-	Author      *AtomAuthor      `xml:"author"` // required
-	Contributor *AtomContributor `xml:"contributor"`
-	Entries     []*AtomEntry     `xml:"entry"`
+	XMLName     xml.Name           `xml:"feed"`
+	Xmlns       string             `xml:"xmlns,attr"`
+	XmlnsMedia  string             `xml:"xmlns:media,attr,omitempty"`
+	Title       string             `xml:"title"`   // required
+	Id          string             `xml:"id"`      // required
+	Updated     string             `xml:"updated"` // required
+	Category    string             `xml:"category,omitempty"`
+	Icon        string             `xml:"icon,omitempty"`
+	Logo        string             `xml:"logo,omitempty"`
+	Rights      string             `xml:"rights,omitempty"` // copyright used
+	Subtitle    string             `xml:"subtitle,omitempty"`
+	Links       []*AtomLink        `xml:"link"`
+	Author      []*AtomAuthor      `xml:"author"` // required
+	Contributor []*AtomContributor `xml:"contributor"`
+	Entries     []*AtomEntry       `xml:"entry"`
+
+	// TTL is a non-standard extension, mirroring RSS's <ttl>, giving the
+	// minimum number of minutes before a feed fetcher should poll again.
+	// Used by NextRefresh when present.
+	TTL string `xml:"ttl,omitempty"`
+
+	// StylesheetHref, when set, is emitted via AtomFeed.XmlPreamble as an
+	// xml-stylesheet processing instruction. It isn't a real Atom element,
+	// so it's excluded from the regular struct encoding.
+	StylesheetHref string `xml:"-"`
 }
 
 type Atom struct {
@@ -102,11 +115,6 @@ func newAtomEntry(i *Item) *AtomEntry {
 			id = "urn:uuid:" + NewUUID().String()
 		}
 	}
-	var name, email string
-	if i.Author != nil {
-		name, email = i.Author.Name, i.Author.Email
-	}
-
 	x := &AtomEntry{
 		Title:   i.Title,
 		Links:   []*AtomLink{&AtomLink{Href: i.Link.Href, Rel: i.Link.Rel}},
@@ -114,9 +122,44 @@ func newAtomEntry(i *Item) *AtomEntry {
 		Id:      id,
 		Updated: anyTimeFormat(time.RFC3339, i.Updated, i.Created),
 	}
-	if len(name) > 0 || len(email) > 0 {
-		x.Author = &AtomAuthor{AtomPerson: AtomPerson{Name: name, Email: email}}
+
+	authors := i.Authors
+	if len(authors) == 0 && i.Author != nil {
+		authors = []*Author{i.Author}
+	}
+	for _, a := range authors {
+		if len(a.Name) > 0 || len(a.Email) > 0 {
+			x.Author = append(x.Author, &AtomAuthor{AtomPerson: AtomPerson{Name: a.Name, Email: a.Email}})
+		}
 	}
+
+	contributors := i.Contributors
+	if len(contributors) == 0 && i.Contributor != nil {
+		contributors = []*Author{i.Contributor}
+	}
+	for _, c := range contributors {
+		x.Contributor = append(x.Contributor, &AtomContributor{AtomPerson: AtomPerson{Name: c.Name, Email: c.Email}})
+	}
+
+	for _, enc := range i.Enclosures {
+		x.Links = append(x.Links, &AtomLink{Href: enc.Url, Rel: "enclosure", Type: enc.Type, Length: enc.Length})
+
+		if x.MediaGroup == nil {
+			x.MediaGroup = &MediaGroup{}
+		}
+		x.MediaGroup.Content = append(x.MediaGroup.Content, &MediaContent{
+			URL:      enc.Url,
+			Type:     enc.Type,
+			Medium:   enc.Medium,
+			Duration: enc.Duration,
+			Width:    enc.Width,
+			Height:   enc.Height,
+		})
+		if len(enc.Thumbnail) > 0 && x.MediaGroup.Thumbnail == nil {
+			x.MediaGroup.Thumbnail = &MediaThumbnail{URL: enc.Thumbnail}
+		}
+	}
+
 	return x
 }
 
@@ -124,22 +167,46 @@ func newAtomEntry(i *Item) *AtomEntry {
 func (a *Atom) AtomFeed() *AtomFeed {
 	updated := anyTimeFormat(time.RFC3339, a.Updated, a.Created)
 	feed := &AtomFeed{
-		Xmlns:    ns,
-		Title:    a.Title,
-		Links:    []*AtomLink{&AtomLink{Href: a.Link.Href, Rel: a.Link.Rel}},
-		Subtitle: a.Description,
-		Id:       a.Link.Href,
-		Updated:  updated,
-		Rights:   a.Copyright,
-	}
-	if a.Author != nil {
-		feed.Author = &AtomAuthor{AtomPerson: AtomPerson{Name: a.Author.Name, Email: a.Author.Email}}
-	} else {
-		feed.Author = &AtomAuthor{AtomPerson: AtomPerson{Name: "", Email: ""}}
+		Xmlns:          ns,
+		Title:          a.Title,
+		Links:          []*AtomLink{&AtomLink{Href: a.Link.Href, Rel: a.Link.Rel}},
+		Subtitle:       a.Description,
+		Id:             a.Link.Href,
+		Updated:        updated,
+		Rights:         a.Copyright,
+		StylesheetHref: a.Stylesheet,
+	}
+	authors := a.Authors
+	if len(authors) == 0 {
+		if a.Author != nil {
+			authors = []*Author{a.Author}
+		} else {
+			authors = []*Author{{}}
+		}
 	}
+	for _, author := range authors {
+		feed.Author = append(feed.Author, &AtomAuthor{AtomPerson: AtomPerson{Name: author.Name, Email: author.Email}})
+	}
+
+	contributors := a.Contributors
+	if len(contributors) == 0 && a.Contributor != nil {
+		contributors = []*Author{a.Contributor}
+	}
+	for _, c := range contributors {
+		feed.Contributor = append(feed.Contributor, &AtomContributor{AtomPerson: AtomPerson{Name: c.Name, Email: c.Email}})
+	}
+
 	for _, e := range a.Items {
 		feed.Entries = append(feed.Entries, newAtomEntry(e))
 	}
+
+	for _, e := range feed.Entries {
+		if e.MediaGroup != nil {
+			feed.XmlnsMedia = mrssNs
+			break
+		}
+	}
+
 	return feed
 }
 
@@ -173,38 +240,123 @@ func (a *AtomEntry) Link(rel string) (string, bool) {
 	return "", false
 }
 
-func ParseAtomFeed(content string) (*AtomFeed, error) {
-	var feed AtomFeed
-	decoder := xml.NewDecoder(bytes.NewBufferString(content))
-	decoder.Strict = true
-
-	if err := decoder.Decode(&feed); err != nil {
-		return nil, err
+// Transform normalizes a into the generic Feed shape used throughout the
+// package, so callers don't need to care whether it came from Atom 1.0,
+// Atom 0.3, or RSS.
+func (a *AtomFeed) Transform() *Feed {
+	feed := &Feed{
+		Title:     a.Title,
+		Id:        a.Id,
+		Subtitle:  a.Subtitle,
+		Copyright: a.Rights,
 	}
-
-	fmt.Printf("%+s", feed)
-
-	return &feed, nil
+	if len(a.Links) > 0 {
+		feed.Link = &Link{Href: a.Links[0].Href, Rel: a.Links[0].Rel}
+	}
+	if t, err := time.Parse(time.RFC3339, a.Updated); err == nil {
+		feed.Updated = t
+	}
+	for _, author := range a.Author {
+		feed.Authors = append(feed.Authors, &Author{Name: author.Name, Email: author.Email})
+	}
+	if len(feed.Authors) > 0 {
+		feed.Author = feed.Authors[0]
+	}
+	for _, c := range a.Contributor {
+		feed.Contributors = append(feed.Contributors, &Author{Name: c.Name, Email: c.Email})
+	}
+	if len(feed.Contributors) > 0 {
+		feed.Contributor = feed.Contributors[0]
+	}
+	for _, e := range a.Entries {
+		feed.Items = append(feed.Items, e.transform())
+	}
+	return feed
 }
 
-func DownloadAtomFeed(url string) (*AtomFeed, error) {
-	client := &http.Client{}
-
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+func (e *AtomEntry) transform() *Item {
+	item := &Item{Title: e.Title, Id: e.Id}
+	if len(e.Links) > 0 {
+		item.Link = &Link{Href: e.Links[0].Href, Rel: e.Links[0].Rel}
+	}
+	if t, err := time.Parse(time.RFC3339, e.Updated); err == nil {
+		item.Updated = t
+	}
+	if e.Content != nil {
+		item.Content = e.Content.Content
+		item.Description = e.Content.Content
+	} else if e.Summary != nil {
+		item.Description = e.Summary.Content
+	}
+	for _, author := range e.Author {
+		item.Authors = append(item.Authors, &Author{Name: author.Name, Email: author.Email})
+	}
+	if len(item.Authors) > 0 {
+		item.Author = item.Authors[0]
 	}
+	for _, c := range e.Contributor {
+		item.Contributors = append(item.Contributors, &Author{Name: c.Name, Email: c.Email})
+	}
+	if len(item.Contributors) > 0 {
+		item.Contributor = item.Contributors[0]
+	}
+	for _, l := range e.Links {
+		if l.Rel == "enclosure" {
+			enc := Enclosure{Url: l.Href, Type: l.Type, Length: l.Length}
+			if e.MediaGroup != nil {
+				for _, mc := range e.MediaGroup.Content {
+					if mc.URL == l.Href {
+						enc.Medium = mc.Medium
+						enc.Duration = mc.Duration
+						enc.Width = mc.Width
+						enc.Height = mc.Height
+						break
+					}
+				}
+				if e.MediaGroup.Thumbnail != nil {
+					enc.Thumbnail = e.MediaGroup.Thumbnail.URL
+				}
+			}
+			item.Enclosures = append(item.Enclosures, enc)
+		}
+	}
+	return item
+}
 
-	request.Header.Add("Accept", "application/atom+xml")
+// XmlPreamble returns the xml-stylesheet processing instruction for a, when
+// Stylesheet is set, so ToXML/WriteXML can emit it between the XML
+// declaration and the marshaled <feed> body. encoding/xml has no way to
+// emit a PI through struct tags, hence the hand-written markup here instead
+// of a field on AtomFeed.
+func (a *Atom) XmlPreamble() string {
+	if len(a.Stylesheet) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("<?xml-stylesheet href=%s type=\"text/xsl\"?>\n", escapeAttr(a.Stylesheet))
+}
 
-	response, err := client.Do(request)
-	if err != nil {
-		return nil, err
+// XmlPreamble returns the xml-stylesheet processing instruction for a, when
+// StylesheetHref is set, for an AtomFeed passed to ToXML/WriteXML directly.
+func (a *AtomFeed) XmlPreamble() string {
+	if len(a.StylesheetHref) == 0 {
+		return ""
 	}
-	defer response.Body.Close()
+	return fmt.Sprintf("<?xml-stylesheet href=%s type=\"text/xsl\"?>\n", escapeAttr(a.StylesheetHref))
+}
+
+// escapeAttr XML-escapes s and wraps it in double quotes for use as an
+// attribute value in hand-written XML, such as a processing instruction.
+func escapeAttr(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return `"` + buf.String() + `"`
+}
 
+func ParseAtomFeed(content string) (*AtomFeed, error) {
 	var feed AtomFeed
-	decoder := xml.NewDecoder(response.Body)
+	decoder := xml.NewDecoder(bytes.NewBufferString(content))
+	decoder.Strict = true
+
 	if err := decoder.Decode(&feed); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,160 @@
+package feeds
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+// Generates/parses Atom 0.3 feeds (http://purl.org/atom/ns#), the format
+// used by many feeds before Atom 1.0 (RFC 4287) was finalized.
+
+const ns03 = "http://purl.org/atom/ns#"
+
+type Atom03Person struct {
+	Name  string `xml:"name,omitempty"`
+	Url   string `xml:"url,omitempty"`
+	Email string `xml:"email,omitempty"`
+}
+
+type Atom03Author struct {
+	XMLName xml.Name `xml:"author"`
+	Atom03Person
+}
+
+type Atom03Contributor struct {
+	XMLName xml.Name `xml:"contributor"`
+	Atom03Person
+}
+
+type Atom03Link struct {
+	XMLName xml.Name `xml:"link"`
+	Href    string   `xml:"href,attr"`
+	Rel     string   `xml:"rel,attr,omitempty"`
+	Type    string   `xml:"type,attr,omitempty"`
+}
+
+type Atom03Content struct {
+	XMLName xml.Name `xml:"content"`
+	Type    string   `xml:"type,attr,omitempty"`
+	Mode    string   `xml:"mode,attr,omitempty"` // "escaped" (default), "base64", or "xml"
+	Content string   `xml:",chardata"`
+	Inner   string   `xml:",innerxml"`
+}
+
+// Decoded returns c's body with its mode applied: base64 bodies are
+// base64-decoded, xml bodies are returned as the preserved inner XML, and
+// escaped bodies (the default) are returned as their XML-unescaped text.
+func (c *Atom03Content) Decoded() (string, error) {
+	switch c.Mode {
+	case "base64":
+		data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(c.Content))
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	case "xml":
+		return strings.TrimSpace(c.Inner), nil
+	default:
+		return c.Content, nil
+	}
+}
+
+type Atom03Entry struct {
+	XMLName     xml.Name `xml:"entry"`
+	Title       string   `xml:"title"`
+	Id          string   `xml:"id"`
+	Issued      string   `xml:"issued,omitempty"`
+	Created     string   `xml:"created,omitempty"`
+	Modified    string   `xml:"modified,omitempty"`
+	Summary     string   `xml:"summary,omitempty"`
+	Content     *Atom03Content
+	Links       []*Atom03Link `xml:"link"`
+	Author      *Atom03Author
+	Contributor []*Atom03Contributor
+}
+
+type Atom03Feed struct {
+	XMLName  xml.Name       `xml:"feed"`
+	Version  string         `xml:"version,attr"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	Title    string         `xml:"title"`
+	Id       string         `xml:"id,omitempty"`
+	Tagline  string         `xml:"tagline,omitempty"`
+	Modified string         `xml:"modified,omitempty"`
+	Links    []*Atom03Link  `xml:"link"`
+	Author   *Atom03Author  // required if an entry lacks an author
+	Entries  []*Atom03Entry `xml:"entry"`
+}
+
+func ParseAtom03Feed(content string) (*Atom03Feed, error) {
+	var feed Atom03Feed
+	decoder := xml.NewDecoder(bytes.NewBufferString(content))
+	decoder.Strict = true
+
+	if err := decoder.Decode(&feed); err != nil {
+		return nil, err
+	}
+
+	return &feed, nil
+}
+
+// Transform normalizes f into the generic Feed shape used throughout the
+// package, regardless of the fact that it was sourced from Atom 0.3.
+func (f *Atom03Feed) Transform() *Feed {
+	feed := &Feed{
+		Title:       f.Title,
+		Id:          f.Id,
+		Description: f.Tagline,
+	}
+	if len(f.Links) > 0 {
+		feed.Link = &Link{Href: f.Links[0].Href, Rel: f.Links[0].Rel, Type: f.Links[0].Type}
+	}
+	if t, err := time.Parse(time.RFC3339, f.Modified); err == nil {
+		feed.Updated = t
+	}
+	if f.Author != nil {
+		feed.Author = &Author{Name: f.Author.Name, Email: f.Author.Email}
+	}
+	for _, e := range f.Entries {
+		feed.Items = append(feed.Items, e.transform())
+	}
+	return feed
+}
+
+func (e *Atom03Entry) transform() *Item {
+	item := &Item{
+		Title: e.Title,
+		Id:    e.Id,
+	}
+	if len(e.Links) > 0 {
+		item.Link = &Link{Href: e.Links[0].Href, Rel: e.Links[0].Rel, Type: e.Links[0].Type}
+	}
+
+	modified := e.Modified
+	if len(modified) == 0 {
+		modified = e.Issued
+	}
+	if t, err := time.Parse(time.RFC3339, modified); err == nil {
+		item.Updated = t
+	}
+	if t, err := time.Parse(time.RFC3339, e.Created); err == nil {
+		item.Created = t
+	}
+
+	if e.Content != nil {
+		if decoded, err := e.Content.Decoded(); err == nil {
+			item.Content = decoded
+			item.Description = decoded
+		}
+	} else {
+		item.Description = e.Summary
+	}
+
+	if e.Author != nil {
+		item.Author = &Author{Name: e.Author.Name, Email: e.Author.Email}
+	}
+	return item
+}
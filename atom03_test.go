@@ -0,0 +1,96 @@
+package feeds
+
+import "testing"
+
+const atom03Sample = `<?xml version="1.0" encoding="utf-8"?>
+<feed version="0.3" xmlns="http://purl.org/atom/ns#">
+  <title>Example Atom 0.3 Feed</title>
+  <link rel="alternate" type="text/html" href="http://example.com/"/>
+  <modified>2006-01-02T15:04:05Z</modified>
+  <author><name>Alice</name><email>alice@example.com</email></author>
+  <entry>
+    <title>Escaped entry</title>
+    <link rel="alternate" type="text/html" href="http://example.com/post1"/>
+    <id>urn:uuid:entry-1</id>
+    <issued>2006-01-02T15:04:05Z</issued>
+    <content type="text/html" mode="escaped">&lt;p&gt;hello&lt;/p&gt;</content>
+  </entry>
+  <entry>
+    <title>Base64 entry</title>
+    <link rel="alternate" type="text/html" href="http://example.com/post2"/>
+    <id>urn:uuid:entry-2</id>
+    <issued>2006-01-02T15:04:05Z</issued>
+    <content type="text/plain" mode="base64">aGVsbG8gd29ybGQ=</content>
+  </entry>
+  <entry>
+    <title>XML entry</title>
+    <link rel="alternate" type="text/html" href="http://example.com/post3"/>
+    <id>urn:uuid:entry-3</id>
+    <issued>2006-01-02T15:04:05Z</issued>
+    <content type="xhtml" mode="xml"><div xmlns="http://www.w3.org/1999/xhtml"><p>hello</p></div></content>
+  </entry>
+</feed>`
+
+func TestParseAtom03Feed(t *testing.T) {
+	feed, err := ParseAtom03Feed(atom03Sample)
+	if err != nil {
+		t.Fatalf("ParseAtom03Feed returned error: %v", err)
+	}
+	if feed.Title != "Example Atom 0.3 Feed" {
+		t.Errorf("unexpected title: %q", feed.Title)
+	}
+	if len(feed.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(feed.Entries))
+	}
+
+	escaped, err := feed.Entries[0].Content.Decoded()
+	if err != nil {
+		t.Fatalf("Decoded returned error: %v", err)
+	}
+	if escaped != "<p>hello</p>" {
+		t.Errorf("expected escaped content to be unescaped, got %q", escaped)
+	}
+
+	decoded, err := feed.Entries[1].Content.Decoded()
+	if err != nil {
+		t.Fatalf("Decoded returned error: %v", err)
+	}
+	if decoded != "hello world" {
+		t.Errorf("expected base64 content to decode to %q, got %q", "hello world", decoded)
+	}
+
+	inner, err := feed.Entries[2].Content.Decoded()
+	if err != nil {
+		t.Fatalf("Decoded returned error: %v", err)
+	}
+	if inner != `<div xmlns="http://www.w3.org/1999/xhtml"><p>hello</p></div>` {
+		t.Errorf("expected xml content to be preserved as inner XML, got %q", inner)
+	}
+}
+
+func TestAtom03FeedTransform(t *testing.T) {
+	feed, err := ParseAtom03Feed(atom03Sample)
+	if err != nil {
+		t.Fatalf("ParseAtom03Feed returned error: %v", err)
+	}
+
+	generic := feed.Transform()
+	if generic.Title != feed.Title {
+		t.Errorf("expected title %q, got %q", feed.Title, generic.Title)
+	}
+	if generic.Author == nil || generic.Author.Name != "Alice" {
+		t.Errorf("expected author Alice, got %+v", generic.Author)
+	}
+	if len(generic.Items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(generic.Items))
+	}
+	if generic.Items[0].Content != "<p>hello</p>" {
+		t.Errorf("expected decoded content on item 0, got %q", generic.Items[0].Content)
+	}
+	if generic.Items[1].Content != "hello world" {
+		t.Errorf("expected decoded content on item 1, got %q", generic.Items[1].Content)
+	}
+	if generic.Items[2].Content != `<div xmlns="http://www.w3.org/1999/xhtml"><p>hello</p></div>` {
+		t.Errorf("expected preserved inner XML on item 2, got %q", generic.Items[2].Content)
+	}
+}
@@ -0,0 +1,62 @@
+package feeds
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteAtomEmitsStylesheetPI(t *testing.T) {
+	feed := &Feed{
+		Title:      "styled feed",
+		Link:       &Link{Href: "http://example.com/"},
+		Id:         "http://example.com/",
+		Created:    time.Now(),
+		Author:     &Author{Name: "Alice"},
+		Stylesheet: "/style.xsl?a=1&b=2",
+	}
+
+	var buf bytes.Buffer
+	if err := feed.WriteAtom(&buf); err != nil {
+		t.Fatalf("WriteAtom returned error: %v", err)
+	}
+	out := buf.String()
+
+	wantDecl := xmlHeaderForTest
+	declIdx := strings.Index(out, wantDecl)
+	if declIdx != 0 {
+		t.Fatalf("expected output to start with the XML declaration, got: %s", out)
+	}
+
+	piWant := `<?xml-stylesheet href="/style.xsl?a=1&amp;b=2" type="text/xsl"?>`
+	piIdx := strings.Index(out, piWant)
+	if piIdx == -1 {
+		t.Fatalf("expected output to contain the stylesheet PI, got: %s", out)
+	}
+
+	feedIdx := strings.Index(out, "<feed")
+	if feedIdx == -1 || !(declIdx < piIdx && piIdx < feedIdx) {
+		t.Fatalf("expected declaration, then PI, then <feed>, got: %s", out)
+	}
+}
+
+func TestWriteAtomWithoutStylesheetOmitsPI(t *testing.T) {
+	feed := &Feed{
+		Title:   "plain feed",
+		Link:    &Link{Href: "http://example.com/"},
+		Id:      "http://example.com/",
+		Created: time.Now(),
+		Author:  &Author{Name: "Alice"},
+	}
+
+	var buf bytes.Buffer
+	if err := feed.WriteAtom(&buf); err != nil {
+		t.Fatalf("WriteAtom returned error: %v", err)
+	}
+	if strings.Contains(buf.String(), "xml-stylesheet") {
+		t.Errorf("expected no stylesheet PI, got: %s", buf.String())
+	}
+}
+
+const xmlHeaderForTest = `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
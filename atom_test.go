@@ -0,0 +1,88 @@
+package feeds
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAtomFeedMultipleAuthorsAndContributors(t *testing.T) {
+	feed := &Feed{
+		Title:   "multi-author feed",
+		Link:    &Link{Href: "http://example.com/"},
+		Id:      "http://example.com/",
+		Created: time.Now(),
+		Authors: []*Author{
+			{Name: "Alice", Email: "alice@example.com"},
+			{Name: "Bob", Email: "bob@example.com"},
+		},
+		Contributors: []*Author{
+			{Name: "Carol", Email: "carol@example.com"},
+		},
+		Items: []*Item{
+			{
+				Title:   "co-authored post",
+				Link:    &Link{Href: "http://example.com/post"},
+				Created: time.Now(),
+				Authors: []*Author{
+					{Name: "Alice", Email: "alice@example.com"},
+					{Name: "Bob", Email: "bob@example.com"},
+				},
+			},
+		},
+	}
+
+	atom := &Atom{feed}
+	atomFeed := atom.AtomFeed()
+
+	if len(atomFeed.Author) != 2 {
+		t.Fatalf("expected 2 feed authors, got %d", len(atomFeed.Author))
+	}
+	if atomFeed.Author[0].Name != "Alice" || atomFeed.Author[1].Name != "Bob" {
+		t.Errorf("unexpected feed authors: %+v", atomFeed.Author)
+	}
+	if len(atomFeed.Contributor) != 1 || atomFeed.Contributor[0].Name != "Carol" {
+		t.Errorf("unexpected feed contributors: %+v", atomFeed.Contributor)
+	}
+
+	entry := atomFeed.Entries[0]
+	if len(entry.Author) != 2 {
+		t.Fatalf("expected 2 entry authors, got %d", len(entry.Author))
+	}
+	if entry.Author[0].Name != "Alice" || entry.Author[1].Name != "Bob" {
+		t.Errorf("unexpected entry authors: %+v", entry.Author)
+	}
+
+	xmlStr, err := ToXML(atom)
+	if err != nil {
+		t.Fatalf("ToXML returned error: %v", err)
+	}
+
+	parsed, err := ParseAtomFeed(xmlStr)
+	if err != nil {
+		t.Fatalf("ParseAtomFeed returned error: %v", err)
+	}
+	if len(parsed.Author) != 2 {
+		t.Fatalf("round-tripped feed: expected 2 authors, got %d", len(parsed.Author))
+	}
+	if len(parsed.Entries) != 1 || len(parsed.Entries[0].Author) != 2 {
+		t.Fatalf("round-tripped entry authors lost: %+v", parsed.Entries)
+	}
+	if strings.Count(xmlStr, "<author>") != 4 {
+		t.Errorf("expected 2 feed-level and 2 entry-level <author> elements in serialized XML, got: %s", xmlStr)
+	}
+}
+
+func TestAtomEntrySingleAuthorBackCompat(t *testing.T) {
+	item := &Item{
+		Title:   "single author post",
+		Link:    &Link{Href: "http://example.com/post"},
+		Created: time.Now(),
+		Author:  &Author{Name: "Alice", Email: "alice@example.com"},
+	}
+
+	entry := newAtomEntry(item)
+	if len(entry.Author) != 1 || entry.Author[0].Name != "Alice" {
+		t.Errorf("expected single back-compat author, got %+v", entry.Author)
+	}
+}
@@ -0,0 +1,237 @@
+package feeds
+
+import (
+	"strings"
+	"time"
+)
+
+// Fluent, chainable builders for AtomFeed and AtomEntry, as an alternative
+// to composing the nested struct literals by hand. Build() validates the
+// fields required by RFC 4287 and returns a *BuildError listing anything
+// missing, so a misused builder fails before the feed is ever serialized.
+
+// BuildError reports the fields a builder was missing when Build was
+// called.
+type BuildError struct {
+	Missing []string
+}
+
+func (e *BuildError) Error() string {
+	return "feeds: missing required field(s): " + strings.Join(e.Missing, ", ")
+}
+
+// FeedBuilder builds an AtomFeed.
+type FeedBuilder struct {
+	feed *AtomFeed
+}
+
+// NewFeedBuilder returns an empty FeedBuilder.
+func NewFeedBuilder() *FeedBuilder {
+	return &FeedBuilder{feed: &AtomFeed{Xmlns: ns}}
+}
+
+func (b *FeedBuilder) Title(s string) *FeedBuilder {
+	b.feed.Title = s
+	return b
+}
+
+func (b *FeedBuilder) Id(s string) *FeedBuilder {
+	b.feed.Id = s
+	return b
+}
+
+func (b *FeedBuilder) Updated(t time.Time) *FeedBuilder {
+	b.feed.Updated = t.Format(time.RFC3339)
+	return b
+}
+
+func (b *FeedBuilder) Subtitle(s string) *FeedBuilder {
+	b.feed.Subtitle = s
+	return b
+}
+
+func (b *FeedBuilder) Rights(s string) *FeedBuilder {
+	b.feed.Rights = s
+	return b
+}
+
+func (b *FeedBuilder) AddLink(l *AtomLink) *FeedBuilder {
+	b.feed.Links = append(b.feed.Links, l)
+	return b
+}
+
+func (b *FeedBuilder) AddAuthor(a *AtomAuthor) *FeedBuilder {
+	b.feed.Author = append(b.feed.Author, a)
+	return b
+}
+
+func (b *FeedBuilder) AddContributor(c *AtomContributor) *FeedBuilder {
+	b.feed.Contributor = append(b.feed.Contributor, c)
+	return b
+}
+
+func (b *FeedBuilder) AddEntry(e *AtomEntry) *FeedBuilder {
+	b.feed.Entries = append(b.feed.Entries, e)
+	return b
+}
+
+// Build validates that title, id, updated, and at least one author (either
+// on the feed itself or on every entry) are set, then returns the built
+// AtomFeed.
+func (b *FeedBuilder) Build() (*AtomFeed, error) {
+	var missing []string
+	if len(b.feed.Title) == 0 {
+		missing = append(missing, "title")
+	}
+	if len(b.feed.Id) == 0 {
+		missing = append(missing, "id")
+	}
+	if len(b.feed.Updated) == 0 {
+		missing = append(missing, "updated")
+	}
+	if len(b.feed.Author) == 0 && !entriesAllHaveAuthor(b.feed.Entries) {
+		missing = append(missing, "author (on the feed, or on every entry)")
+	}
+	if len(missing) > 0 {
+		return nil, &BuildError{Missing: missing}
+	}
+	return b.feed, nil
+}
+
+func entriesAllHaveAuthor(entries []*AtomEntry) bool {
+	if len(entries) == 0 {
+		return false
+	}
+	for _, e := range entries {
+		if len(e.Author) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// EntryBuilder builds an AtomEntry.
+type EntryBuilder struct {
+	entry *AtomEntry
+}
+
+// NewEntryBuilder returns an empty EntryBuilder.
+func NewEntryBuilder() *EntryBuilder {
+	return &EntryBuilder{entry: &AtomEntry{}}
+}
+
+func (b *EntryBuilder) Title(s string) *EntryBuilder {
+	b.entry.Title = s
+	return b
+}
+
+func (b *EntryBuilder) Id(s string) *EntryBuilder {
+	b.entry.Id = s
+	return b
+}
+
+func (b *EntryBuilder) Updated(t time.Time) *EntryBuilder {
+	b.entry.Updated = t.Format(time.RFC3339)
+	return b
+}
+
+func (b *EntryBuilder) Content(content, typ string) *EntryBuilder {
+	b.entry.Content = &AtomContent{Content: content, Type: typ}
+	return b
+}
+
+func (b *EntryBuilder) AddLink(l *AtomLink) *EntryBuilder {
+	b.entry.Links = append(b.entry.Links, l)
+	return b
+}
+
+func (b *EntryBuilder) AddAuthor(a *AtomAuthor) *EntryBuilder {
+	b.entry.Author = append(b.entry.Author, a)
+	return b
+}
+
+func (b *EntryBuilder) AddContributor(c *AtomContributor) *EntryBuilder {
+	b.entry.Contributor = append(b.entry.Contributor, c)
+	return b
+}
+
+// Build validates that title, id, and updated are set, then returns the
+// built AtomEntry.
+func (b *EntryBuilder) Build() (*AtomEntry, error) {
+	var missing []string
+	if len(b.entry.Title) == 0 {
+		missing = append(missing, "title")
+	}
+	if len(b.entry.Id) == 0 {
+		missing = append(missing, "id")
+	}
+	if len(b.entry.Updated) == 0 {
+		missing = append(missing, "updated")
+	}
+	if len(missing) > 0 {
+		return nil, &BuildError{Missing: missing}
+	}
+	return b.entry, nil
+}
+
+// AuthorBuilder builds an AtomAuthor.
+type AuthorBuilder struct {
+	author *AtomAuthor
+}
+
+// NewAuthorBuilder returns an empty AuthorBuilder.
+func NewAuthorBuilder() *AuthorBuilder {
+	return &AuthorBuilder{author: &AtomAuthor{}}
+}
+
+func (b *AuthorBuilder) Name(s string) *AuthorBuilder {
+	b.author.Name = s
+	return b
+}
+
+func (b *AuthorBuilder) Email(s string) *AuthorBuilder {
+	b.author.Email = s
+	return b
+}
+
+func (b *AuthorBuilder) Uri(s string) *AuthorBuilder {
+	b.author.Uri = s
+	return b
+}
+
+// Build validates that at least a name or an email is set, then returns the
+// built AtomAuthor.
+func (b *AuthorBuilder) Build() (*AtomAuthor, error) {
+	if len(b.author.Name) == 0 && len(b.author.Email) == 0 {
+		return nil, &BuildError{Missing: []string{"name or email"}}
+	}
+	return b.author, nil
+}
+
+// LinkBuilder builds an AtomLink.
+type LinkBuilder struct {
+	link *AtomLink
+}
+
+// NewLinkBuilder returns an empty LinkBuilder.
+func NewLinkBuilder() *LinkBuilder {
+	return &LinkBuilder{link: &AtomLink{}}
+}
+
+func (b *LinkBuilder) Href(s string) *LinkBuilder {
+	b.link.Href = s
+	return b
+}
+
+func (b *LinkBuilder) Rel(s string) *LinkBuilder {
+	b.link.Rel = s
+	return b
+}
+
+// Build validates that href is set, then returns the built AtomLink.
+func (b *LinkBuilder) Build() (*AtomLink, error) {
+	if len(b.link.Href) == 0 {
+		return nil, &BuildError{Missing: []string{"href"}}
+	}
+	return b.link, nil
+}
@@ -0,0 +1,80 @@
+package feeds
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFeedBuilderBuildsValidFeed(t *testing.T) {
+	author, err := NewAuthorBuilder().Name("Alice").Email("alice@example.com").Build()
+	if err != nil {
+		t.Fatalf("AuthorBuilder.Build returned error: %v", err)
+	}
+	link, err := NewLinkBuilder().Href("http://example.com/").Rel("self").Build()
+	if err != nil {
+		t.Fatalf("LinkBuilder.Build returned error: %v", err)
+	}
+	entry, err := NewEntryBuilder().
+		Title("hello").
+		Id("urn:uuid:1").
+		Updated(time.Now()).
+		AddAuthor(author).
+		Build()
+	if err != nil {
+		t.Fatalf("EntryBuilder.Build returned error: %v", err)
+	}
+
+	feed, err := NewFeedBuilder().
+		Title("my feed").
+		Id("http://example.com/").
+		Updated(time.Now()).
+		AddLink(link).
+		AddEntry(entry).
+		Build()
+	if err != nil {
+		t.Fatalf("FeedBuilder.Build returned error: %v", err)
+	}
+	if feed.Title != "my feed" || len(feed.Entries) != 1 {
+		t.Errorf("unexpected feed: %+v", feed)
+	}
+}
+
+func TestFeedBuilderMissingFields(t *testing.T) {
+	_, err := NewFeedBuilder().Title("my feed").Build()
+	if err == nil {
+		t.Fatal("expected an error for a feed missing id, updated, and author")
+	}
+	buildErr, ok := err.(*BuildError)
+	if !ok {
+		t.Fatalf("expected *BuildError, got %T", err)
+	}
+	if len(buildErr.Missing) != 3 {
+		t.Errorf("expected 3 missing fields, got %v", buildErr.Missing)
+	}
+}
+
+func TestFeedBuilderAuthorOnEveryEntrySatisfiesRequirement(t *testing.T) {
+	author, _ := NewAuthorBuilder().Name("Alice").Build()
+	entry, _ := NewEntryBuilder().
+		Title("hello").
+		Id("urn:uuid:1").
+		Updated(time.Now()).
+		AddAuthor(author).
+		Build()
+
+	_, err := NewFeedBuilder().
+		Title("my feed").
+		Id("http://example.com/").
+		Updated(time.Now()).
+		AddEntry(entry).
+		Build()
+	if err != nil {
+		t.Errorf("expected no error when every entry has an author, got: %v", err)
+	}
+}
+
+func TestLinkBuilderRequiresHref(t *testing.T) {
+	if _, err := NewLinkBuilder().Rel("self").Build(); err == nil {
+		t.Error("expected an error for a link missing href")
+	}
+}
@@ -0,0 +1,87 @@
+package feeds
+
+import "time"
+
+// Feed holds the generic feed data that Atom feeds are rendered from and that
+// ParseFeed normalizes Atom 1.0, Atom 0.3 and RSS into. Not all fields are
+// used by every format.
+type Feed struct {
+	Title        string
+	Link         *Link
+	Description  string
+	Author       *Author
+	Authors      []*Author
+	Contributor  *Author
+	Contributors []*Author
+	Updated      time.Time
+	Created      time.Time
+	Id           string
+	Subtitle     string
+	Items        []*Item
+	Copyright    string
+	Image        *Image
+	Stylesheet   string // href of an XSLT stylesheet to reference via an xml-stylesheet PI
+}
+
+// Item is a single entry in a Feed.
+type Item struct {
+	Title        string
+	Link         *Link
+	Source       *Link
+	Author       *Author
+	Authors      []*Author
+	Contributor  *Author
+	Contributors []*Author
+	Description  string // used as description in rss, content in atom
+	Id           string // used as guid in rss, id in atom
+	IsPermaLink  string // if the id is permalink
+	Updated      time.Time
+	Created      time.Time
+	Enclosure    *Enclosure
+	Enclosures   []Enclosure
+	Content      string
+}
+
+// Author is a person associated with a Feed or an Item.
+type Author struct {
+	Name  string
+	Email string
+}
+
+// Link is a hyperlink associated with a Feed or an Item.
+type Link struct {
+	Href, Rel, Type, Length string
+}
+
+// Image is a feed-level image, such as a logo.
+type Image struct {
+	Url, Title, Link string
+	Width, Height    int
+}
+
+// Enclosure is a media object attached to an Item. Medium, Duration, Width,
+// Height and Thumbnail are optional MRSS metadata rendered into Atom as a
+// media:group alongside the enclosure link.
+type Enclosure struct {
+	Url, Length, Type string
+	Medium            string
+	Duration          int
+	Width, Height     int
+	Thumbnail         string
+}
+
+// Add appends an Item to the Feed.
+func (f *Feed) Add(item *Item) {
+	f.Items = append(f.Items, item)
+}
+
+// anyTimeFormat returns the first non-zero time formatted with format, or ""
+// if every time is zero.
+func anyTimeFormat(format string, times ...time.Time) string {
+	for _, t := range times {
+		if !t.IsZero() {
+			return t.Format(format)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,254 @@
+package feeds
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotModified is returned by FeedFetcher.Fetch when the server responds
+// with HTTP 304, or when the feed's NextRefresh time hasn't passed yet, so
+// callers know to keep using the *AtomFeed they already have.
+var ErrNotModified = errors.New("feeds: feed not modified")
+
+// CacheEntry holds the conditional-GET and refresh-timing state a Cache
+// persists per feed URL.
+type CacheEntry struct {
+	ETag         string
+	LastModified string
+	NextRefresh  time.Time
+}
+
+// Cache persists CacheEntry values per feed URL, so FeedFetcher can send
+// conditional GETs across process restarts.
+type Cache interface {
+	Get(url string) (CacheEntry, bool)
+	Set(url string, entry CacheEntry)
+}
+
+// MemoryCache is a Cache backed by an in-memory map. Entries don't survive
+// process restarts.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]CacheEntry
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]CacheEntry)}
+}
+
+func (c *MemoryCache) Get(url string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[url]
+	return entry, ok
+}
+
+func (c *MemoryCache) Set(url string, entry CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[url] = entry
+}
+
+// DiskCache is a Cache backed by a single JSON file, so entries survive
+// process restarts.
+type DiskCache struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewDiskCache returns a DiskCache persisting to path. path is created on
+// first Set; it does not need to exist yet.
+func NewDiskCache(path string) *DiskCache {
+	return &DiskCache{path: path}
+}
+
+func (c *DiskCache) Get(url string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries, err := c.load()
+	if err != nil {
+		return CacheEntry{}, false
+	}
+	entry, ok := entries[url]
+	return entry, ok
+}
+
+func (c *DiskCache) Set(url string, entry CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries, err := c.load()
+	if err != nil {
+		entries = make(map[string]CacheEntry)
+	}
+	entries[url] = entry
+	// best-effort: a failed write just means the next Fetch re-downloads
+	c.save(entries)
+}
+
+func (c *DiskCache) load() (map[string]CacheEntry, error) {
+	data, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[string]CacheEntry), nil
+		}
+		return nil, err
+	}
+	entries := make(map[string]CacheEntry)
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (c *DiskCache) save(entries map[string]CacheEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, data, 0644)
+}
+
+// FeedFetcher downloads Atom feeds using conditional GET (If-None-Match /
+// If-Modified-Since), so polling an unchanged feed costs a cheap 304
+// instead of a full download - the lack of which is what gets feed
+// fetchers banned by hosts. It also honors each feed's own minimum refresh
+// interval, from either the Cache-Control: max-age response header or the
+// feed's own TTL hint.
+type FeedFetcher struct {
+	Client    *http.Client
+	UserAgent string
+	Cache     Cache
+}
+
+// NewFeedFetcher returns a FeedFetcher using http.DefaultClient and an
+// in-memory Cache.
+func NewFeedFetcher() *FeedFetcher {
+	return &FeedFetcher{
+		Client:    http.DefaultClient,
+		UserAgent: "feeds/1.0 (+https://github.com/pjvds/feeds)",
+		Cache:     NewMemoryCache(),
+	}
+}
+
+// Fetch downloads the Atom feed at url. It returns ErrNotModified, without
+// making a request, if url's cached NextRefresh hasn't passed yet, and
+// again if the server responds with 304 Not Modified.
+func (f *FeedFetcher) Fetch(url string) (*AtomFeed, error) {
+	entry, _ := f.Cache.Get(url)
+	if !entry.NextRefresh.IsZero() && time.Now().Before(entry.NextRefresh) {
+		return nil, ErrNotModified
+	}
+
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/atom+xml")
+	request.Header.Set("Accept-Encoding", "gzip, deflate")
+	if len(f.UserAgent) > 0 {
+		request.Header.Set("User-Agent", f.UserAgent)
+	}
+	if len(entry.ETag) > 0 {
+		request.Header.Set("If-None-Match", entry.ETag)
+	}
+	if len(entry.LastModified) > 0 {
+		request.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+
+	response, err := f.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotModified {
+		if refresh := maxAgeRefresh(response.Header); !refresh.IsZero() {
+			entry.NextRefresh = refresh
+		}
+		f.Cache.Set(url, entry)
+		return nil, ErrNotModified
+	}
+
+	body, err := decodeBody(response)
+	if err != nil {
+		return nil, err
+	}
+
+	var feed AtomFeed
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	if err := decoder.Decode(&feed); err != nil {
+		return nil, err
+	}
+
+	entry.ETag = response.Header.Get("ETag")
+	entry.LastModified = response.Header.Get("Last-Modified")
+	entry.NextRefresh = NextRefresh(&feed)
+	if refresh := maxAgeRefresh(response.Header); refresh.After(entry.NextRefresh) {
+		entry.NextRefresh = refresh
+	}
+	f.Cache.Set(url, entry)
+
+	return &feed, nil
+}
+
+// NextRefresh returns the earliest time feed should be fetched again,
+// derived from feed's TTL hint, or the zero Time if feed has none.
+func NextRefresh(feed *AtomFeed) time.Time {
+	if feed == nil || len(feed.TTL) == 0 {
+		return time.Time{}
+	}
+	minutes, err := strconv.Atoi(strings.TrimSpace(feed.TTL))
+	if err != nil || minutes <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(minutes) * time.Minute)
+}
+
+// maxAgeRefresh returns the time derived from a Cache-Control: max-age
+// directive in header, or the zero Time if there isn't one.
+func maxAgeRefresh(header http.Header) time.Time {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(directive)
+		if !strings.HasPrefix(directive, "max-age=") {
+			continue
+		}
+		seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+		if err != nil || seconds <= 0 {
+			continue
+		}
+		return time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+	return time.Time{}
+}
+
+// decodeBody reads response's body, transparently decompressing it
+// according to its Content-Encoding.
+func decodeBody(response *http.Response) ([]byte, error) {
+	switch response.Header.Get("Content-Encoding") {
+	case "gzip":
+		gz, err := gzip.NewReader(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		return ioutil.ReadAll(gz)
+	case "deflate":
+		fl := flate.NewReader(response.Body)
+		defer fl.Close()
+		return ioutil.ReadAll(fl)
+	default:
+		return ioutil.ReadAll(response.Body)
+	}
+}
@@ -0,0 +1,114 @@
+package feeds
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const fetchSampleFeed = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>fetchable feed</title>
+  <id>http://example.com/</id>
+  <updated>2006-01-02T15:04:05Z</updated>
+</feed>`
+
+func TestFeedFetcherConditionalGet(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(fetchSampleFeed))
+	}))
+	defer server.Close()
+
+	fetcher := NewFeedFetcher()
+
+	feed, err := fetcher.Fetch(server.URL)
+	if err != nil {
+		t.Fatalf("first Fetch returned error: %v", err)
+	}
+	if feed.Title != "fetchable feed" {
+		t.Errorf("unexpected title: %q", feed.Title)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+
+	cached, ok := fetcher.Cache.Get(server.URL)
+	if !ok || cached.ETag != `"v1"` {
+		t.Fatalf("expected ETag to be cached, got %+v", cached)
+	}
+
+	_, err = fetcher.Fetch(server.URL)
+	if err != ErrNotModified {
+		t.Fatalf("expected ErrNotModified on the second fetch, got: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests (second returning 304), got %d", requests)
+	}
+}
+
+func TestFeedFetcherHonorsNextRefresh(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Cache-Control", "max-age=3600")
+		w.Write([]byte(fetchSampleFeed))
+	}))
+	defer server.Close()
+
+	fetcher := NewFeedFetcher()
+
+	if _, err := fetcher.Fetch(server.URL); err != nil {
+		t.Fatalf("first Fetch returned error: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+
+	if _, err := fetcher.Fetch(server.URL); err != ErrNotModified {
+		t.Fatalf("expected ErrNotModified while within the max-age window, got: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected no additional request within the max-age window, got %d total", requests)
+	}
+}
+
+func TestNextRefreshFromTTL(t *testing.T) {
+	refresh := NextRefresh(&AtomFeed{TTL: "30"})
+	if refresh.IsZero() {
+		t.Fatal("expected a non-zero NextRefresh for a feed with a TTL hint")
+	}
+	if !refresh.After(time.Now()) {
+		t.Errorf("expected NextRefresh to be in the future, got %v", refresh)
+	}
+}
+
+func TestNextRefreshWithoutTTL(t *testing.T) {
+	if refresh := NextRefresh(&AtomFeed{}); !refresh.IsZero() {
+		t.Errorf("expected zero NextRefresh without a TTL hint, got %v", refresh)
+	}
+}
+
+func TestDiskCacheRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewDiskCache(dir + "/cache.json")
+
+	entry := CacheEntry{ETag: `"abc"`, LastModified: "Mon, 02 Jan 2006 15:04:05 GMT"}
+	cache.Set("http://example.com/feed.atom", entry)
+
+	reloaded := NewDiskCache(dir + "/cache.json")
+	got, ok := reloaded.Get("http://example.com/feed.atom")
+	if !ok {
+		t.Fatal("expected cache entry to round-trip through disk")
+	}
+	if got.ETag != entry.ETag || got.LastModified != entry.LastModified {
+		t.Errorf("unexpected round-tripped entry: %+v", got)
+	}
+}
@@ -0,0 +1,40 @@
+package feeds
+
+import "encoding/xml"
+
+// Media RSS (MRSS) support for per-entry media metadata, used by podcast
+// and video feeds. See http://www.rssboard.org/media-rss.
+
+const mrssNs = "http://search.yahoo.com/mrss/"
+
+// MediaContent describes a single media:content element.
+type MediaContent struct {
+	XMLName  xml.Name `xml:"http://search.yahoo.com/mrss/ content"`
+	URL      string   `xml:"url,attr"`
+	Type     string   `xml:"type,attr,omitempty"`
+	Medium   string   `xml:"medium,attr,omitempty"`
+	Duration int      `xml:"duration,attr,omitempty"`
+	Width    int      `xml:"width,attr,omitempty"`
+	Height   int      `xml:"height,attr,omitempty"`
+}
+
+// MediaThumbnail describes a single media:thumbnail element.
+type MediaThumbnail struct {
+	XMLName xml.Name `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+	URL     string   `xml:"url,attr"`
+	Width   int      `xml:"width,attr,omitempty"`
+	Height  int      `xml:"height,attr,omitempty"`
+}
+
+// MediaGroup wraps the media:content and media:thumbnail elements for a
+// single entry in a media:group block.
+//
+// encoding/xml resolves "media:group"-style tags to the bare local name plus
+// the namespace URI bound by xmlns:media once it sees that attribute, so
+// decoding (unlike encoding) requires the tag to spell out the namespace URI
+// explicitly or the incoming elements silently fail to match.
+type MediaGroup struct {
+	XMLName   xml.Name        `xml:"http://search.yahoo.com/mrss/ group"`
+	Content   []*MediaContent `xml:"http://search.yahoo.com/mrss/ content,omitempty"`
+	Thumbnail *MediaThumbnail `xml:"http://search.yahoo.com/mrss/ thumbnail,omitempty"`
+}
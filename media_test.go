@@ -0,0 +1,94 @@
+package feeds
+
+import (
+	"strings"
+	"time"
+
+	"testing"
+)
+
+func TestAtomEntryEnclosures(t *testing.T) {
+	feed := &Feed{
+		Title:   "podcast feed",
+		Link:    &Link{Href: "http://example.com/"},
+		Id:      "http://example.com/",
+		Created: time.Now(),
+		Author:  &Author{Name: "Alice"},
+		Items: []*Item{
+			{
+				Title:   "episode one",
+				Link:    &Link{Href: "http://example.com/ep1"},
+				Created: time.Now(),
+				Enclosures: []Enclosure{
+					{
+						Url:       "http://example.com/ep1.mp3",
+						Type:      "audio/mpeg",
+						Length:    "123456",
+						Medium:    "audio",
+						Duration:  600,
+						Thumbnail: "http://example.com/ep1-thumb.jpg",
+					},
+				},
+			},
+		},
+	}
+
+	atom := &Atom{feed}
+	atomFeed := atom.AtomFeed()
+
+	if atomFeed.XmlnsMedia != mrssNs {
+		t.Errorf("expected xmlns:media to be declared, got %q", atomFeed.XmlnsMedia)
+	}
+
+	entry := atomFeed.Entries[0]
+	var enclosureLink *AtomLink
+	for _, l := range entry.Links {
+		if l.Rel == "enclosure" {
+			enclosureLink = l
+		}
+	}
+	if enclosureLink == nil {
+		t.Fatal("expected an enclosure link")
+	}
+	if enclosureLink.Href != "http://example.com/ep1.mp3" || enclosureLink.Type != "audio/mpeg" || enclosureLink.Length != "123456" {
+		t.Errorf("unexpected enclosure link: %+v", enclosureLink)
+	}
+
+	if entry.MediaGroup == nil || len(entry.MediaGroup.Content) != 1 || entry.MediaGroup.Content[0].URL != "http://example.com/ep1.mp3" {
+		t.Fatalf("unexpected media group: %+v", entry.MediaGroup)
+	}
+	if entry.MediaGroup.Content[0].Medium != "audio" || entry.MediaGroup.Content[0].Duration != 600 {
+		t.Errorf("unexpected media content metadata: %+v", entry.MediaGroup.Content[0])
+	}
+	if entry.MediaGroup.Thumbnail == nil || entry.MediaGroup.Thumbnail.URL != "http://example.com/ep1-thumb.jpg" {
+		t.Errorf("unexpected media thumbnail: %+v", entry.MediaGroup.Thumbnail)
+	}
+
+	xmlStr, err := ToXML(atom)
+	if err != nil {
+		t.Fatalf("ToXML returned error: %v", err)
+	}
+	if !strings.Contains(xmlStr, `xmlns:media="http://search.yahoo.com/mrss/"`) {
+		t.Errorf("expected xmlns:media attribute in output, got: %s", xmlStr)
+	}
+	if !strings.Contains(xmlStr, "<group ") || !strings.Contains(xmlStr, "<thumbnail ") {
+		t.Errorf("expected a media group with a thumbnail in output, got: %s", xmlStr)
+	}
+
+	parsed, err := ParseAtomFeed(xmlStr)
+	if err != nil {
+		t.Fatalf("ParseAtomFeed returned error: %v", err)
+	}
+
+	generic := parsed.Transform()
+	if len(generic.Items) != 1 || len(generic.Items[0].Enclosures) != 1 {
+		t.Fatalf("round-tripped enclosures lost: %+v", generic.Items)
+	}
+	enc := generic.Items[0].Enclosures[0]
+	if enc.Url != "http://example.com/ep1.mp3" || enc.Type != "audio/mpeg" || enc.Length != "123456" {
+		t.Errorf("unexpected round-tripped enclosure: %+v", enc)
+	}
+	if enc.Medium != "audio" || enc.Duration != 600 || enc.Thumbnail != "http://example.com/ep1-thumb.jpg" {
+		t.Errorf("unexpected round-tripped media metadata: %+v", enc)
+	}
+}
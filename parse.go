@@ -0,0 +1,56 @@
+package feeds
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// ParseFeed detects the format of content (Atom 0.3, Atom 1.0, or RSS) from
+// its root element and namespace, parses it with the matching parser, and
+// normalizes the result into a generic *Feed so callers don't need to care
+// which format they were handed.
+func ParseFeed(content string) (*Feed, error) {
+	root, err := rootElement(content)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case root.Name.Local == "rss":
+		feed, err := ParseRssFeed(content)
+		if err != nil {
+			return nil, err
+		}
+		return feed.Transform(), nil
+	case root.Name.Local == "feed" && root.Name.Space == ns03:
+		feed, err := ParseAtom03Feed(content)
+		if err != nil {
+			return nil, err
+		}
+		return feed.Transform(), nil
+	case root.Name.Local == "feed":
+		feed, err := ParseAtomFeed(content)
+		if err != nil {
+			return nil, err
+		}
+		return feed.Transform(), nil
+	default:
+		return nil, fmt.Errorf("feeds: unrecognized feed format (root element %q)", root.Name.Local)
+	}
+}
+
+// rootElement returns content's first start element without decoding the
+// rest of the document, so ParseFeed can dispatch on its name/namespace.
+func rootElement(content string) (xml.StartElement, error) {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			return se, nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package feeds
+
+import "testing"
+
+func TestParseFeedDispatchesAtom1(t *testing.T) {
+	const sample = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Atom 1.0 Feed</title>
+  <id>http://example.com/</id>
+  <updated>2006-01-02T15:04:05Z</updated>
+</feed>`
+
+	feed, err := ParseFeed(sample)
+	if err != nil {
+		t.Fatalf("ParseFeed returned error: %v", err)
+	}
+	if feed.Title != "Atom 1.0 Feed" {
+		t.Errorf("unexpected title: %q", feed.Title)
+	}
+}
+
+func TestParseFeedDispatchesAtom03(t *testing.T) {
+	feed, err := ParseFeed(atom03Sample)
+	if err != nil {
+		t.Fatalf("ParseFeed returned error: %v", err)
+	}
+	if feed.Title != "Example Atom 0.3 Feed" {
+		t.Errorf("unexpected title: %q", feed.Title)
+	}
+}
+
+func TestParseFeedDispatchesRss(t *testing.T) {
+	const sample = `<?xml version="1.0" encoding="utf-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Example RSS Feed</title>
+    <link>http://example.com/</link>
+    <description>an example feed</description>
+    <item>
+      <title>post one</title>
+      <link>http://example.com/post1</link>
+      <description>the first post</description>
+    </item>
+  </channel>
+</rss>`
+
+	feed, err := ParseFeed(sample)
+	if err != nil {
+		t.Fatalf("ParseFeed returned error: %v", err)
+	}
+	if feed.Title != "Example RSS Feed" {
+		t.Errorf("unexpected title: %q", feed.Title)
+	}
+	if len(feed.Items) != 1 || feed.Items[0].Title != "post one" {
+		t.Errorf("unexpected items: %+v", feed.Items)
+	}
+}
+
+func TestParseFeedUnrecognizedFormat(t *testing.T) {
+	if _, err := ParseFeed(`<unknown/>`); err == nil {
+		t.Error("expected an error for an unrecognized root element")
+	}
+}
@@ -0,0 +1,88 @@
+package feeds
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+// Minimal RSS 2.0 support, just enough to let ParseFeed recognize and
+// normalize RSS alongside the Atom formats.
+
+type RssImage struct {
+	XMLName xml.Name `xml:"image"`
+	Url     string   `xml:"url"`
+	Title   string   `xml:"title"`
+	Link    string   `xml:"link"`
+	Width   int      `xml:"width,omitempty"`
+	Height  int      `xml:"height,omitempty"`
+}
+
+type RssItem struct {
+	XMLName     xml.Name `xml:"item"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Author      string   `xml:"author,omitempty"`
+	Guid        string   `xml:"guid,omitempty"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+}
+
+type RssFeed struct {
+	XMLName     xml.Name   `xml:"rss"`
+	Version     string     `xml:"version,attr"`
+	Title       string     `xml:"channel>title"`
+	Link        string     `xml:"channel>link"`
+	Description string     `xml:"channel>description"`
+	Copyright   string     `xml:"channel>copyright,omitempty"`
+	Image       *RssImage  `xml:"channel>image"`
+	Items       []*RssItem `xml:"channel>item"`
+}
+
+func ParseRssFeed(content string) (*RssFeed, error) {
+	var feed RssFeed
+	decoder := xml.NewDecoder(bytes.NewBufferString(content))
+	decoder.Strict = true
+
+	if err := decoder.Decode(&feed); err != nil {
+		return nil, err
+	}
+
+	return &feed, nil
+}
+
+// Transform normalizes r into the generic Feed shape used throughout the
+// package.
+func (r *RssFeed) Transform() *Feed {
+	feed := &Feed{
+		Title:       r.Title,
+		Link:        &Link{Href: r.Link},
+		Description: r.Description,
+		Copyright:   r.Copyright,
+	}
+	if r.Image != nil {
+		feed.Image = &Image{
+			Url:    r.Image.Url,
+			Title:  r.Image.Title,
+			Link:   r.Image.Link,
+			Width:  r.Image.Width,
+			Height: r.Image.Height,
+		}
+	}
+	for _, it := range r.Items {
+		item := &Item{
+			Title:       it.Title,
+			Link:        &Link{Href: it.Link},
+			Description: it.Description,
+			Id:          it.Guid,
+		}
+		if len(it.Author) > 0 {
+			item.Author = &Author{Name: it.Author}
+		}
+		if t, err := time.Parse(time.RFC1123Z, it.PubDate); err == nil {
+			item.Created = t
+		}
+		feed.Items = append(feed.Items, item)
+	}
+	return feed
+}
@@ -0,0 +1,24 @@
+package feeds
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// UUID is a version 4 (random) UUID as described in RFC 4122.
+type UUID [16]byte
+
+// NewUUID generates a new random (version 4) UUID.
+func NewUUID() *UUID {
+	u := new(UUID)
+	if _, err := rand.Read(u[:]); err != nil {
+		panic(err)
+	}
+	u[8] = (u[8] | 0x80) & 0xBF
+	u[6] = (u[6] & 0xF) | (4 << 4)
+	return u
+}
+
+func (u *UUID) String() string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
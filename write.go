@@ -0,0 +1,67 @@
+package feeds
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// XmlFeed is implemented by feed types that can render themselves as XML.
+type XmlFeed interface {
+	FeedXml() interface{}
+}
+
+// xmlPreambler is optionally implemented by feed types that need to emit
+// hand-written markup (such as an xml-stylesheet processing instruction)
+// between the XML declaration and the marshaled body. encoding/xml has no
+// way to produce a processing instruction through struct tags, which is why
+// this is a side channel rather than a field on the marshaled type.
+type xmlPreambler interface {
+	XmlPreamble() string
+}
+
+// ToXML renders feed as a complete XML document, including the XML
+// declaration.
+func ToXML(feed XmlFeed) (string, error) {
+	x := feed.FeedXml()
+	data, err := xml.MarshalIndent(x, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	var preamble string
+	if p, ok := feed.(xmlPreambler); ok {
+		preamble = p.XmlPreamble()
+	}
+	return xml.Header + preamble + string(data), nil
+}
+
+// WriteXML writes feed as a complete XML document, including the XML
+// declaration, to w.
+func WriteXML(feed XmlFeed, w io.Writer) error {
+	x := feed.FeedXml()
+	data, err := xml.MarshalIndent(x, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return err
+	}
+	if p, ok := feed.(xmlPreambler); ok {
+		if preamble := p.XmlPreamble(); len(preamble) > 0 {
+			if _, err := w.Write([]byte(preamble)); err != nil {
+				return err
+			}
+		}
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// ToAtom renders f as an Atom feed.
+func (f *Feed) ToAtom() (string, error) {
+	return ToXML(&Atom{f})
+}
+
+// WriteAtom writes f as an Atom feed to w.
+func (f *Feed) WriteAtom(w io.Writer) error {
+	return WriteXML(&Atom{f}, w)
+}